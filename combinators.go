@@ -0,0 +1,146 @@
+package main
+
+// fromSliceRev builds a List[T] from items without reversing element order,
+// i.e. the first element of items becomes the Head of the result.
+func fromSliceRev[T any](items []T) List[T] {
+	var result List[T] = EmptyList[T]{}
+	for i := len(items) - 1; i >= 0; i-- {
+		result = Node[T]{val: items[i], next: result}
+	}
+	return result
+}
+
+// FlatMap applies f to each element of l and concatenates the resulting
+// lists, in order, into a single List[T].
+func FlatMap[F any, T any](l List[F], f func(F) List[T]) List[T] {
+	var flat []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		for inner := f(cur.Head()); !inner.IsEmpty(); inner = inner.Tail() {
+			flat = append(flat, inner.Head())
+		}
+	}
+	return fromSliceRev(flat)
+}
+
+// FilterMap applies f to each element of l, keeping the transformed value
+// wherever f reports true and discarding the element otherwise.
+func FilterMap[F any, T any](l List[F], f func(F) (T, bool)) List[T] {
+	var kept []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		if v, ok := f(cur.Head()); ok {
+			kept = append(kept, v)
+		}
+	}
+	return fromSliceRev(kept)
+}
+
+// Reverse returns a new list with the elements of l in reverse order, built
+// in a single pass over l.
+func Reverse[T any](l List[T]) List[T] {
+	var result List[T] = EmptyList[T]{}
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		result = Node[T]{val: cur.Head(), next: result}
+	}
+	return result
+}
+
+// Pair holds two values of possibly different types, produced by Zip.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines two lists elementwise into a list of Pair, stopping as soon
+// as either input is exhausted.
+func Zip[A any, B any](a List[A], b List[B]) List[Pair[A, B]] {
+	var zipped []Pair[A, B]
+	ca, cb := a, b
+	for !ca.IsEmpty() && !cb.IsEmpty() {
+		zipped = append(zipped, Pair[A, B]{First: ca.Head(), Second: cb.Head()})
+		ca, cb = ca.Tail(), cb.Tail()
+	}
+	return fromSliceRev(zipped)
+}
+
+// Unzip splits a list of Pair back into its two component lists.
+func Unzip[A any, B any](l List[Pair[A, B]]) (List[A], List[B]) {
+	var firsts []A
+	var seconds []B
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		p := cur.Head()
+		firsts = append(firsts, p.First)
+		seconds = append(seconds, p.Second)
+	}
+	return fromSliceRev(firsts), fromSliceRev(seconds)
+}
+
+// GroupBy partitions the elements of l into buckets keyed by key, preserving
+// the relative order of elements within each bucket.
+func GroupBy[T any, K comparable](l List[T], key func(T) K) map[K]List[T] {
+	buckets := make(map[K][]T)
+	var order []K
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		v := cur.Head()
+		k := key(v)
+		if _, seen := buckets[k]; !seen {
+			order = append(order, k)
+		}
+		buckets[k] = append(buckets[k], v)
+	}
+	result := make(map[K]List[T], len(buckets))
+	for _, k := range order {
+		result[k] = fromSliceRev(buckets[k])
+	}
+	return result
+}
+
+// Partition splits l into two lists: elements for which p is true, and
+// elements for which p is false, each preserving relative order.
+func Partition[T any](l List[T], p func(T) bool) (List[T], List[T]) {
+	var yes, no []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		if v := cur.Head(); p(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return fromSliceRev(yes), fromSliceRev(no)
+}
+
+// Chunk splits l into consecutive sublists of at most size elements each.
+// It panics if size is not positive.
+func Chunk[T any](l List[T], size int) List[List[T]] {
+	if size <= 0 {
+		panic("Chunk: size must be positive")
+	}
+	var chunks []List[T]
+	var current []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		current = append(current, cur.Head())
+		if len(current) == size {
+			chunks = append(chunks, fromSliceRev(current))
+			current = nil
+		}
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, fromSliceRev(current))
+	}
+	return fromSliceRev(chunks)
+}
+
+// Uniq returns a new list containing the elements of l with later duplicates
+// removed, preserving the order of first occurrence.
+func Uniq[T comparable](l List[T]) List[T] {
+	seen := make(map[T]struct{})
+	var kept []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		v := cur.Head()
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		kept = append(kept, v)
+	}
+	return fromSliceRev(kept)
+}
@@ -0,0 +1,228 @@
+package main
+
+import "testing"
+
+func emptyIntList() List[int] { return EmptyList[int]{} }
+
+func TestFlatMap(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []int
+		f    func(int) List[int]
+		want []int
+	}{
+		{
+			name: "normal",
+			in:   []int{1, 2, 3},
+			f:    func(x int) List[int] { return fromSliceRev([]int{x, x * 10}) },
+			want: []int{1, 10, 2, 20, 3, 30},
+		},
+		{
+			name: "empty input",
+			in:   nil,
+			f:    func(x int) List[int] { return fromSliceRev([]int{x}) },
+			want: nil,
+		},
+		{
+			name: "f returns empty for some elements",
+			in:   []int{1, 2, 3},
+			f: func(x int) List[int] {
+				if x == 2 {
+					return emptyIntList()
+				}
+				return fromSliceRev([]int{x})
+			},
+			want: []int{1, 3},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := toSlice(FlatMap(fromSliceRev(c.in), c.f))
+			if !intSlicesEqual(got, c.want) {
+				t.Fatalf("FlatMap(%v) = %v, want %v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	double := func(x int) (int, bool) {
+		if x%2 != 0 {
+			return 0, false
+		}
+		return x * 2, true
+	}
+	cases := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"normal", []int{1, 2, 3, 4, 5, 6}, []int{4, 8, 12}},
+		{"empty", nil, nil},
+		{"none kept", []int{1, 3, 5}, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := toSlice(FilterMap(fromSliceRev(c.in), double))
+			if !intSlicesEqual(got, c.want) {
+				t.Fatalf("FilterMap(%v) = %v, want %v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"normal", []int{1, 2, 3}, []int{3, 2, 1}},
+		{"empty", nil, nil},
+		{"single", []int{1}, []int{1}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := toSlice(Reverse(fromSliceRev(c.in)))
+			if !intSlicesEqual(got, c.want) {
+				t.Fatalf("Reverse(%v) = %v, want %v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestZipAndUnzip(t *testing.T) {
+	cases := []struct {
+		name       string
+		as, bs     []int
+		wantFirst  []int
+		wantSecond []int
+	}{
+		{"equal length", []int{1, 2, 3}, []int{10, 20, 30}, []int{1, 2, 3}, []int{10, 20, 30}},
+		{"a shorter", []int{1, 2}, []int{10, 20, 30}, []int{1, 2}, []int{10, 20}},
+		{"b shorter", []int{1, 2, 3}, []int{10, 20}, []int{1, 2}, []int{10, 20}},
+		{"either empty", nil, []int{10, 20}, nil, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			zipped := Zip(fromSliceRev(c.as), fromSliceRev(c.bs))
+			firsts, seconds := Unzip(zipped)
+			if got := toSlice(firsts); !intSlicesEqual(got, c.wantFirst) {
+				t.Fatalf("Unzip firsts = %v, want %v", got, c.wantFirst)
+			}
+			if got := toSlice(seconds); !intSlicesEqual(got, c.wantSecond) {
+				t.Fatalf("Unzip seconds = %v, want %v", got, c.wantSecond)
+			}
+		})
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	l := fromSliceRev([]int{1, 2, 3, 4, 5, 6})
+	groups := GroupBy(l, func(x int) int { return x % 3 })
+
+	want := map[int][]int{0: {3, 6}, 1: {1, 4}, 2: {2, 5}}
+	if len(groups) != len(want) {
+		t.Fatalf("GroupBy produced %d keys, want %d", len(groups), len(want))
+	}
+	for k, wantVals := range want {
+		got := toSlice(groups[k])
+		if !intSlicesEqual(got, wantVals) {
+			t.Fatalf("GroupBy key %d = %v, want %v", k, got, wantVals)
+		}
+	}
+}
+
+func TestGroupByEmpty(t *testing.T) {
+	groups := GroupBy(emptyIntList(), func(x int) int { return x })
+	if len(groups) != 0 {
+		t.Fatalf("GroupBy(empty) = %v, want empty map", groups)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      []int
+		wantYes []int
+		wantNo  []int
+	}{
+		{"normal", []int{1, 2, 3, 4, 5}, []int{2, 4}, []int{1, 3, 5}},
+		{"empty", nil, nil, nil},
+		{"all yes", []int{2, 4}, []int{2, 4}, nil},
+		{"all no", []int{1, 3}, nil, []int{1, 3}},
+	}
+	even := func(x int) bool { return x%2 == 0 }
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			yes, no := Partition(fromSliceRev(c.in), even)
+			if got := toSlice(yes); !intSlicesEqual(got, c.wantYes) {
+				t.Fatalf("Partition yes = %v, want %v", got, c.wantYes)
+			}
+			if got := toSlice(no); !intSlicesEqual(got, c.wantNo) {
+				t.Fatalf("Partition no = %v, want %v", got, c.wantNo)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []int
+		size int
+		want [][]int
+	}{
+		{"evenly divisible", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"non-divisible remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"size larger than list", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty list", nil, 3, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			chunks := toSlice(Chunk(fromSliceRev(c.in), c.size))
+			if len(chunks) != len(c.want) {
+				t.Fatalf("Chunk(%v, %d) produced %d chunks, want %d", c.in, c.size, len(chunks), len(c.want))
+			}
+			for i, want := range c.want {
+				if got := toSlice(chunks[i]); !intSlicesEqual(got, want) {
+					t.Fatalf("Chunk(%v, %d)[%d] = %v, want %v", c.in, c.size, i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestChunkPanicsOnNonPositiveSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("Chunk(_, %d) did not panic", size)
+				}
+			}()
+			Chunk(fromSliceRev([]int{1, 2, 3}), size)
+		}()
+	}
+}
+
+func TestUniq(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"normal", []int{1, 2, 2, 3, 1, 4}, []int{1, 2, 3, 4}},
+		{"empty", nil, nil},
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"all duplicates", []int{5, 5, 5}, []int{5}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := toSlice(Uniq(fromSliceRev(c.in)))
+			if !intSlicesEqual(got, c.want) {
+				t.Fatalf("Uniq(%v) = %v, want %v", c.in, got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package main
+
+import "iter"
+
+// Iterator is a pull-style cursor over a sequence of T: callers call
+// HasNext before each Next, and Next advances the cursor and returns the
+// current element.
+type Iterator[T any] interface {
+	HasNext() bool
+	Next() T
+}
+
+// listIterator is the Iterator[T] implementation returned by NewIterator.
+type listIterator[T any] struct {
+	cur List[T]
+}
+
+// NewIterator returns an Iterator[T] that yields the elements of l in order.
+func NewIterator[T any](l List[T]) Iterator[T] {
+	return &listIterator[T]{cur: l}
+}
+
+// HasNext reports whether there are more elements to yield.
+func (it *listIterator[T]) HasNext() bool {
+	return !it.cur.IsEmpty()
+}
+
+// Next returns the current element and advances the cursor. It panics if
+// called when HasNext would report false.
+func (it *listIterator[T]) Next() T {
+	v := it.cur.Head()
+	it.cur = it.cur.Tail()
+	return v
+}
+
+// All returns l's elements as an iter.Seq[T], so callers can write
+// for v := range l.All() using Go 1.23's range-over-func.
+func All[T any](l List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+			if !yield(cur.Head()) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns l's elements paired with their zero-based index as an
+// iter.Seq2[int, T], so callers can write for i, v := range l.Enumerate().
+func Enumerate[T any](l List[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+			if !yield(i, cur.Head()) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// FromIter ingests any standard iter.Seq[T] - including those produced from
+// channels, maps, and slices by the maps/slices packages - into a List[T].
+func FromIter[T any](seq iter.Seq[T]) List[T] {
+	var items []T
+	seq(func(v T) bool {
+		items = append(items, v)
+		return true
+	})
+	return fromSliceRev(items)
+}
@@ -0,0 +1,223 @@
+package main
+
+import "sync"
+
+// LazyList is a lazily-evaluated, memoized cons-list: the head and tail are
+// produced on demand by a thunk and computed at most once. It complements
+// List[T], which is always fully materialized, by allowing infinite or
+// expensive-to-compute sequences to be expressed and consumed incrementally.
+type LazyList[T any] struct {
+	cell *lazyCell[T]
+}
+
+// lazyCell holds the thunk for a LazyList cell plus the memoized result of
+// forcing it. once guarantees the thunk runs at most one time even if Force
+// is called concurrently or repeatedly.
+type lazyCell[T any] struct {
+	once  sync.Once
+	thunk func() (T, LazyList[T], bool)
+	val   T
+	next  LazyList[T]
+	ok    bool
+}
+
+// newLazyList wraps thunk in a LazyList cell.
+func newLazyList[T any](thunk func() (T, LazyList[T], bool)) LazyList[T] {
+	return LazyList[T]{cell: &lazyCell[T]{thunk: thunk}}
+}
+
+// lazyNil is the empty LazyList value: Force reports ok=false for it.
+func lazyNil[T any]() LazyList[T] {
+	return newLazyList(func() (T, LazyList[T], bool) {
+		var zero T
+		return zero, LazyList[T]{}, false
+	})
+}
+
+// lazyCons builds a LazyList whose head is val and whose tail is produced by next.
+func lazyCons[T any](val T, next func() LazyList[T]) LazyList[T] {
+	return newLazyList(func() (T, LazyList[T], bool) {
+		return val, next(), true
+	})
+}
+
+// Force evaluates the thunk at most once (memoizing the result) and returns
+// the head value, the tail, and whether the list is non-empty. Calling Force
+// repeatedly on the same LazyList never re-runs the underlying computation.
+func (l LazyList[T]) Force() (T, LazyList[T], bool) {
+	l.cell.once.Do(func() {
+		l.cell.val, l.cell.next, l.cell.ok = l.cell.thunk()
+	})
+	return l.cell.val, l.cell.next, l.cell.ok
+}
+
+// IsEmpty reports whether the lazy list has no elements.
+func (l LazyList[T]) IsEmpty() bool {
+	_, _, ok := l.Force()
+	return !ok
+}
+
+// Head returns the first element, forcing evaluation if necessary.
+// It panics if the list is empty.
+func (l LazyList[T]) Head() T {
+	v, _, ok := l.Force()
+	if !ok {
+		panic("LazyList has no Head")
+	}
+	return v
+}
+
+// Tail returns the remainder of the list, forcing evaluation if necessary.
+// It panics if the list is empty.
+func (l LazyList[T]) Tail() LazyList[T] {
+	_, next, ok := l.Force()
+	if !ok {
+		panic("LazyList has no Tail")
+	}
+	return next
+}
+
+// Repeat returns an infinite LazyList in which every element is v.
+func Repeat[T any](v T) LazyList[T] {
+	var self func() LazyList[T]
+	self = func() LazyList[T] { return lazyCons(v, self) }
+	return self()
+}
+
+// Iterate returns the infinite LazyList seed, f(seed), f(f(seed)), ...
+func Iterate[T any](seed T, f func(T) T) LazyList[T] {
+	var build func(T) LazyList[T]
+	build = func(cur T) LazyList[T] {
+		return lazyCons(cur, func() LazyList[T] { return build(f(cur)) })
+	}
+	return build(seed)
+}
+
+// Cycle returns an infinite LazyList that repeats the elements of l forever.
+// Cycle of an empty list returns an empty LazyList.
+func Cycle[T any](l List[T]) LazyList[T] {
+	if l.IsEmpty() {
+		return lazyNil[T]()
+	}
+	var fromNode func(List[T]) LazyList[T]
+	fromNode = func(cur List[T]) LazyList[T] {
+		if cur.IsEmpty() {
+			return fromNode(l)
+		}
+		return lazyCons(cur.Head(), func() LazyList[T] { return fromNode(cur.Tail()) })
+	}
+	return fromNode(l)
+}
+
+// LazyMap returns a lazy counterpart of Map: applying f to each element is
+// deferred until the result is forced, so the spine is never evaluated ahead
+// of consumption.
+func LazyMap[F any, T any](l LazyList[F], f func(F) T) LazyList[T] {
+	return newLazyList(func() (T, LazyList[T], bool) {
+		v, next, ok := l.Force()
+		if !ok {
+			var zero T
+			return zero, LazyList[T]{}, false
+		}
+		return f(v), LazyMap(next, f), true
+	})
+}
+
+// LazyFilter returns a lazy counterpart of Filter: elements are tested only
+// as far as consumption demands, which is required for filtering infinite
+// streams.
+func LazyFilter[T any](l LazyList[T], p func(T) bool) LazyList[T] {
+	return newLazyList(func() (T, LazyList[T], bool) {
+		cur := l
+		for {
+			v, next, ok := cur.Force()
+			if !ok {
+				var zero T
+				return zero, LazyList[T]{}, false
+			}
+			if p(v) {
+				return v, LazyFilter(next, p), true
+			}
+			cur = next
+		}
+	})
+}
+
+// Take returns a strict List[T] containing at most the first n elements of l.
+func Take[T any](l LazyList[T], n int) List[T] {
+	var taken []T
+	cur := l
+	for i := 0; i < n; i++ {
+		v, next, ok := cur.Force()
+		if !ok {
+			break
+		}
+		taken = append(taken, v)
+		cur = next
+	}
+	var result List[T] = EmptyList[T]{}
+	for i := len(taken) - 1; i >= 0; i-- {
+		result = Node[T]{val: taken[i], next: result}
+	}
+	return result
+}
+
+// TakeWhile returns a strict List[T] of the longest prefix of l whose
+// elements all satisfy pred.
+func TakeWhile[T any](l LazyList[T], pred func(T) bool) List[T] {
+	var taken []T
+	cur := l
+	for {
+		v, next, ok := cur.Force()
+		if !ok || !pred(v) {
+			break
+		}
+		taken = append(taken, v)
+		cur = next
+	}
+	var result List[T] = EmptyList[T]{}
+	for i := len(taken) - 1; i >= 0; i-- {
+		result = Node[T]{val: taken[i], next: result}
+	}
+	return result
+}
+
+// Drop returns the LazyList remaining after skipping the first n elements.
+func Drop[T any](l LazyList[T], n int) LazyList[T] {
+	cur := l
+	for i := 0; i < n; i++ {
+		_, next, ok := cur.Force()
+		if !ok {
+			return cur
+		}
+		cur = next
+	}
+	return cur
+}
+
+// DropWhile returns the LazyList remaining after skipping the longest prefix
+// whose elements all satisfy pred.
+func DropWhile[T any](l LazyList[T], pred func(T) bool) LazyList[T] {
+	cur := l
+	for {
+		v, next, ok := cur.Force()
+		if !ok || !pred(v) {
+			return cur
+		}
+		cur = next
+	}
+}
+
+// ForEach applies f to successive elements of l, forcing at most n cells, so
+// consuming a finite prefix of an infinite LazyList never over-forces the spine.
+func (l LazyList[T]) ForEach(n int, f func(T)) {
+	cur := l
+	for i := 0; i < n; i++ {
+		v, next, ok := cur.Force()
+		if !ok {
+			return
+		}
+		f(v)
+		cur = next
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import "testing"
+
+// TestLazyListMemoizesThunk locks in the thunk-memoization invariant: Force
+// (and anything built on it - Head, Tail, IsEmpty) must run the underlying
+// thunk at most once, however many times it is called or however many
+// copies of the LazyList value are in play.
+func TestLazyListMemoizesThunk(t *testing.T) {
+	var calls int
+	l := newLazyList(func() (int, LazyList[int], bool) {
+		calls++
+		return 42, lazyNil[int](), true
+	})
+
+	// copies share the underlying cell, so forcing any of them must only
+	// run the thunk once in total.
+	a := l
+	b := l
+
+	if v := a.Head(); v != 42 {
+		t.Fatalf("Head() = %d, want 42", v)
+	}
+	if v := b.Head(); v != 42 {
+		t.Fatalf("Head() (copy) = %d, want 42", v)
+	}
+	_ = l.IsEmpty()
+	_, _, _ = l.Force()
+
+	if calls != 1 {
+		t.Fatalf("thunk ran %d times, want exactly 1", calls)
+	}
+}
+
+// TestTakeAndDropOverRepeat exercises Take/Drop over an infinite Repeat
+// stream.
+func TestTakeAndDropOverRepeat(t *testing.T) {
+	l := Repeat(7)
+	if got := toSlice(Take(l, 3)); !intSlicesEqual(got, []int{7, 7, 7}) {
+		t.Fatalf("Take(Repeat(7), 3) = %v, want [7 7 7]", got)
+	}
+	if got := toSlice(Take(Drop(l, 5), 2)); !intSlicesEqual(got, []int{7, 7}) {
+		t.Fatalf("Take(Drop(Repeat(7), 5), 2) = %v, want [7 7]", got)
+	}
+}
+
+// TestTakeWhileAndDropWhileOverIterate exercises TakeWhile/DropWhile over an
+// infinite Iterate stream of powers of two.
+func TestTakeWhileAndDropWhileOverIterate(t *testing.T) {
+	powersOfTwo := Iterate(1, func(x int) int { return x * 2 })
+
+	got := toSlice(TakeWhile(powersOfTwo, func(x int) bool { return x < 100 }))
+	want := []int{1, 2, 4, 8, 16, 32, 64}
+	if !intSlicesEqual(got, want) {
+		t.Fatalf("TakeWhile(<100) = %v, want %v", got, want)
+	}
+
+	rest := DropWhile(powersOfTwo, func(x int) bool { return x < 100 })
+	if got := toSlice(Take(rest, 2)); !intSlicesEqual(got, []int{128, 256}) {
+		t.Fatalf("Take(DropWhile(<100), 2) = %v, want [128 256]", got)
+	}
+}
+
+// TestCycleRepeatsUnderlyingList exercises Cycle over a finite List[T].
+func TestCycleRepeatsUnderlyingList(t *testing.T) {
+	l := FromSlice([]int{1, 2, 3})
+	base := toSlice(l)
+
+	cycled := toSlice(Take(Cycle(l), len(base)*2+1))
+	want := append(append([]int{}, base...), append(base, base[0])...)
+	if !intSlicesEqual(cycled, want) {
+		t.Fatalf("Take(Cycle(l), 2n+1) = %v, want %v", cycled, want)
+	}
+}
+
+// TestCycleOfEmptyList is empty, per the documented contract.
+func TestCycleOfEmptyList(t *testing.T) {
+	var empty List[int] = EmptyList[int]{}
+	if !Cycle(empty).IsEmpty() {
+		t.Fatalf("Cycle(EmptyList) should be empty")
+	}
+}
+
+// TestLazyMapAndLazyFilterDontOverForce checks that consuming a finite
+// prefix of a LazyMap/LazyFilter over an infinite Iterate does not force
+// more of the underlying spine than the prefix requires.
+func TestLazyMapAndLazyFilterDontOverForce(t *testing.T) {
+	const n = 10
+	var calls int
+	source := Iterate(0, func(x int) int {
+		calls++
+		return x + 1
+	})
+
+	mapped := LazyMap(source, func(x int) int { return x * 2 })
+	got := toSlice(Take(mapped, n))
+	want := []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}
+	if !intSlicesEqual(got, want) {
+		t.Fatalf("Take(LazyMap(Iterate), %d) = %v, want %v", n, got, want)
+	}
+	if calls > n {
+		t.Fatalf("generator function called %d times to produce %d elements; spine was over-forced", calls, n)
+	}
+
+	calls = 0
+	source2 := Iterate(0, func(x int) int {
+		calls++
+		return x + 1
+	})
+	filtered := LazyFilter(source2, func(x int) bool { return x%2 == 0 })
+	got = toSlice(Take(filtered, n))
+	if len(got) != n {
+		t.Fatalf("Take(LazyFilter(Iterate), %d) returned %d elements, want %d", n, len(got), n)
+	}
+	// Producing n even numbers forces roughly 2n generator steps (half are
+	// filtered out); it must not run away and force the whole infinite spine.
+	if calls > 4*n {
+		t.Fatalf("generator function called %d times to produce %d filtered elements; spine was over-forced", calls, n)
+	}
+}
+
+// TestForEachBoundedOnInfiniteList checks that ForEach(n, f) visits exactly
+// n elements of an infinite LazyList without forcing beyond that prefix.
+func TestForEachBoundedOnInfiniteList(t *testing.T) {
+	const n = 5
+	var calls int
+	source := Iterate(0, func(x int) int {
+		calls++
+		return x + 1
+	})
+
+	var seen []int
+	source.ForEach(n, func(x int) { seen = append(seen, x) })
+
+	want := []int{0, 1, 2, 3, 4}
+	if !intSlicesEqual(seen, want) {
+		t.Fatalf("ForEach(%d, ...) visited %v, want %v", n, seen, want)
+	}
+	if calls > n {
+		t.Fatalf("generator function called %d times for ForEach(%d, ...); spine was over-forced", calls, n)
+	}
+}
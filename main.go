@@ -28,8 +28,9 @@ func (n Node[T]) IsEmpty() bool { return false }
 func (n Node[T]) Head() T       { return n.val }
 func (n Node[T]) Tail() List[T] { return n.next }
 func (n Node[T]) ForEach(f func(T)) {
-	f(n.val)
-	n.next.ForEach(f)
+	for l := List[T](n); !l.IsEmpty(); l = l.Tail() {
+		f(l.Head())
+	}
 }
 
 // Prepend returns a new list with val added to the front of the existing list.
@@ -38,12 +39,18 @@ func Prepend[T any](l List[T], val T) List[T] {
 }
 
 // Append returns a new list with val added to the end of the existing list.
+// It walks l once to collect its elements and rebuilds the list from the tail,
+// so it never recurses, keeping stack usage flat for lists of any length.
 func Append[T any](l List[T], val T) List[T] {
-	if l.IsEmpty() {
-		return Node[T]{val: val, next: EmptyList[T]{}}
+	var elems []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		elems = append(elems, cur.Head())
+	}
+	result := List[T](Node[T]{val: val, next: EmptyList[T]{}})
+	for i := len(elems) - 1; i >= 0; i-- {
+		result = Node[T]{val: elems[i], next: result}
 	}
-	n := l.(Node[T])
-	return Node[T]{val: n.val, next: Append(n.next, val)}
+	return result
 }
 
 // FromSlice constructs a List[T] from a slice by prepending elements in reverse order.
@@ -56,58 +63,62 @@ func FromSlice[T any](items []T) List[T] {
 }
 
 // Map applies a function f to each element in the input list and returns a new list of the results.
+// It builds the output tail-first into a slice and stitches Node[T] cells back together in a
+// second pass, so the recursion depth of the original implementation no longer applies.
 func Map[F any, T any](l List[F], f func(F) T) List[T] {
-	if l.IsEmpty() {
-		var empty EmptyList[T]
-		return empty
+	var mapped []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		mapped = append(mapped, f(cur.Head()))
+	}
+	var result List[T] = EmptyList[T]{}
+	for i := len(mapped) - 1; i >= 0; i-- {
+		result = Node[T]{val: mapped[i], next: result}
 	}
-	n := l.(Node[F])
-	return Node[T]{val: f(n.val), next: Map(n.next, f)}
+	return result
 }
 
 // Filter returns a new list containing only the elements that satisfy the predicate p.
+// Matching elements are collected into a slice and the result list is rebuilt from the
+// tail in a single additional pass, avoiding recursion on Tail.
 func Filter[T any](l List[T], p func(T) bool) List[T] {
-	if l.IsEmpty() {
-		return l
+	var kept []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		if v := cur.Head(); p(v) {
+			kept = append(kept, v)
+		}
 	}
-	n := l.(Node[T])
-	if p(n.val) {
-		return Node[T]{val: n.val, next: Filter(n.next, p)}
-	} else {
-		return Filter(n.next, p)
+	var result List[T] = EmptyList[T]{}
+	for i := len(kept) - 1; i >= 0; i-- {
+		result = Node[T]{val: kept[i], next: result}
 	}
+	return result
 }
 
 // Fold reduces the list using a binary function f and an initial accumulator acc.
 func Fold[F any, T any](l List[F], f func(F, T) T, acc T) T {
-	if l.IsEmpty() {
-		return acc
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		acc = f(cur.Head(), acc)
 	}
-	n := l.(Node[F])
-	newAcc := f(n.val, acc)
-	return Fold(n.next, f, newAcc)
+	return acc
 }
 
 // Length returns the number of elements in the list.
 func Length[T any](l List[T]) uint {
-	return Fold(
-		l,
-		func(v T, acc uint) uint { return acc + 1 },
-		0,
-	)
+	var count uint
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		count++
+	}
+	return count
 }
 
 // Contains returns true if elem is found in the list; otherwise false.
 func Contains[T comparable](l List[T], elem T) bool {
-	if l.IsEmpty() {
-		return false
-	}
-	n := l.(Node[T])
-	if elem == n.val {
-		return true
-	} else {
-		return Contains(n.next, elem)
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		if cur.Head() == elem {
+			return true
+		}
 	}
+	return false
 }
 
 func main() {
@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+// BenchmarkMillionElementList constructs a million-element list and runs the
+// iterative core operations over it, locking in that Append/Map/Filter/Fold/
+// ForEach/Contains/Length no longer recurse on Tail and so don't blow the
+// stack at this size.
+func BenchmarkMillionElementList(b *testing.B) {
+	const n = 1_000_000
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+
+	for i := 0; i < b.N; i++ {
+		l := FromSlice(items)
+		l = Append(l, n)
+		mapped := Map(l, func(x int) int { return x + 1 })
+		filtered := Filter(mapped, func(x int) bool { return x%2 == 0 })
+		sum := Fold(filtered, func(x, acc int) int { return x + acc }, 0)
+		count := 0
+		filtered.ForEach(func(int) { count++ })
+		_ = Length(filtered)
+		_ = Contains(filtered, -1)
+		_ = sum
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+// Compare orders two keys of type K, returning a negative number if a sorts
+// before b, zero if they are equivalent, and a positive number if a sorts
+// after b. It is stored inside OrderedMap rather than required as a method
+// on K or a type constraint, so callers can plug in arbitrary orderings
+// (reverse, by secondary key, ...) at construction time.
+type Compare[K any] func(a, b K) int
+
+// bstNode is a node of the persistent AVL tree backing OrderedMap.
+// Inserting or deleting a key copies only the path from the root to the
+// affected node (rebalancing may copy a few additional nodes along that
+// same path); every other subtree is shared with the previous version of
+// the tree. height keeps the tree balanced so Insert/Lookup/Delete stay
+// O(log n) even for monotonic key sequences (auto-increment ids, sorted
+// input, ...), which would otherwise degenerate a plain BST into a
+// linked list.
+type bstNode[K any, V any] struct {
+	key         K
+	val         V
+	height      int
+	left, right *bstNode[K, V]
+}
+
+// height returns n's height, treating nil as height 0.
+func height[K any, V any](n *bstNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// newNode returns a node with its height computed from its children.
+func newNode[K any, V any](key K, val V, left, right *bstNode[K, V]) *bstNode[K, V] {
+	h := height(left)
+	if rh := height(right); rh > h {
+		h = rh
+	}
+	return &bstNode[K, V]{key: key, val: val, left: left, right: right, height: h + 1}
+}
+
+// balanceFactor is height(left) - height(right); a correctly balanced AVL
+// node always has a balance factor in [-1, 1].
+func balanceFactor[K any, V any](n *bstNode[K, V]) int {
+	return height(n.left) - height(n.right)
+}
+
+// rotateRight performs a single right rotation around n, returning the new
+// subtree root.
+func rotateRight[K any, V any](n *bstNode[K, V]) *bstNode[K, V] {
+	l := n.left
+	return newNode(l.key, l.val, l.left, newNode(n.key, n.val, l.right, n.right))
+}
+
+// rotateLeft performs a single left rotation around n, returning the new
+// subtree root.
+func rotateLeft[K any, V any](n *bstNode[K, V]) *bstNode[K, V] {
+	r := n.right
+	return newNode(r.key, r.val, newNode(n.key, n.val, n.left, r.left), r.right)
+}
+
+// rebalance restores the AVL invariant at n, assuming both of n's children
+// are already balanced.
+func rebalance[K any, V any](n *bstNode[K, V]) *bstNode[K, V] {
+	switch bf := balanceFactor(n); {
+	case bf > 1:
+		if balanceFactor(n.left) < 0 {
+			n = newNode(n.key, n.val, rotateLeft(n.left), n.right)
+		}
+		return rotateRight(n)
+	case bf < -1:
+		if balanceFactor(n.right) > 0 {
+			n = newNode(n.key, n.val, n.left, rotateRight(n.right))
+		}
+		return rotateLeft(n)
+	default:
+		return n
+	}
+}
+
+// OrderedMap is an immutable, ordered map backed by a persistent AVL tree
+// keyed by an explicit Compare[K], rather than by comparable or
+// constraints.Ordered. Insert, Lookup, and Delete are all O(log n),
+// including for monotonic key sequences such as auto-increment ids or
+// already-sorted input.
+type OrderedMap[K any, V any] struct {
+	cmp  Compare[K]
+	root *bstNode[K, V]
+}
+
+// EmptyOrderedMap returns an empty OrderedMap that orders keys using cmp.
+func EmptyOrderedMap[K any, V any](cmp Compare[K]) OrderedMap[K, V] {
+	return OrderedMap[K, V]{cmp: cmp}
+}
+
+// Insert returns a new OrderedMap with key bound to val, sharing every
+// subtree of the receiver that the insertion path does not pass through.
+func (m OrderedMap[K, V]) Insert(key K, val V) OrderedMap[K, V] {
+	return OrderedMap[K, V]{cmp: m.cmp, root: insertNode(m.root, m.cmp, key, val)}
+}
+
+func insertNode[K any, V any](n *bstNode[K, V], cmp Compare[K], key K, val V) *bstNode[K, V] {
+	if n == nil {
+		return newNode(key, val, nil, nil)
+	}
+	switch c := cmp(key, n.key); {
+	case c < 0:
+		return rebalance(newNode(n.key, n.val, insertNode(n.left, cmp, key, val), n.right))
+	case c > 0:
+		return rebalance(newNode(n.key, n.val, n.left, insertNode(n.right, cmp, key, val)))
+	default:
+		return newNode(key, val, n.left, n.right)
+	}
+}
+
+// Lookup returns the value bound to key and true, or the zero value and
+// false if key is not present.
+func (m OrderedMap[K, V]) Lookup(key K) (V, bool) {
+	n := m.root
+	for n != nil {
+		switch c := m.cmp(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.val, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete returns a new OrderedMap with key removed, or the receiver
+// unchanged (sharing its tree) if key is not present.
+func (m OrderedMap[K, V]) Delete(key K) OrderedMap[K, V] {
+	return OrderedMap[K, V]{cmp: m.cmp, root: deleteNode(m.root, m.cmp, key)}
+}
+
+func deleteNode[K any, V any](n *bstNode[K, V], cmp Compare[K], key K) *bstNode[K, V] {
+	if n == nil {
+		return nil
+	}
+	switch c := cmp(key, n.key); {
+	case c < 0:
+		return rebalance(newNode(n.key, n.val, deleteNode(n.left, cmp, key), n.right))
+	case c > 0:
+		return rebalance(newNode(n.key, n.val, n.left, deleteNode(n.right, cmp, key)))
+	default:
+		switch {
+		case n.left == nil:
+			return n.right
+		case n.right == nil:
+			return n.left
+		default:
+			succ := minNode(n.right)
+			return rebalance(newNode(succ.key, succ.val, n.left, deleteNode(n.right, cmp, succ.key)))
+		}
+	}
+}
+
+func minNode[K any, V any](n *bstNode[K, V]) *bstNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// Keys returns the map's keys as a List[K] in ascending order.
+func (m OrderedMap[K, V]) Keys() List[K] {
+	var keys []K
+	inorder(m.root, func(n *bstNode[K, V]) { keys = append(keys, n.key) })
+	return fromSliceRev(keys)
+}
+
+// Values returns the map's values as a List[V], ordered by their keys in
+// ascending order.
+func (m OrderedMap[K, V]) Values() List[V] {
+	var values []V
+	inorder(m.root, func(n *bstNode[K, V]) { values = append(values, n.val) })
+	return fromSliceRev(values)
+}
+
+// ForEach applies f to every key/value pair in ascending key order.
+func (m OrderedMap[K, V]) ForEach(f func(K, V)) {
+	inorder(m.root, func(n *bstNode[K, V]) { f(n.key, n.val) })
+}
+
+// inorder visits every node of the tree rooted at n in ascending key order.
+func inorder[K any, V any](n *bstNode[K, V], visit func(*bstNode[K, V])) {
+	if n == nil {
+		return
+	}
+	inorder(n.left, visit)
+	visit(n)
+	inorder(n.right, visit)
+}
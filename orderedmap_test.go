@@ -0,0 +1,83 @@
+package main
+
+import "testing"
+
+// TestOrderedMapReverseCompare exercises Insert/Lookup/Delete with a
+// non-default Compare (descending order), locking in that OrderedMap
+// dispatches on the comparator stored as a field rather than on any
+// property of K itself.
+func TestOrderedMapReverseCompare(t *testing.T) {
+	reverse := func(a, b int) int { return b - a }
+
+	cases := []struct {
+		name string
+		do   func(m OrderedMap[int, string]) OrderedMap[int, string]
+	}{
+		{"insert 3", func(m OrderedMap[int, string]) OrderedMap[int, string] { return m.Insert(3, "three") }},
+		{"insert 1", func(m OrderedMap[int, string]) OrderedMap[int, string] { return m.Insert(1, "one") }},
+		{"insert 2", func(m OrderedMap[int, string]) OrderedMap[int, string] { return m.Insert(2, "two") }},
+	}
+
+	m := EmptyOrderedMap[int, string](reverse)
+	for _, c := range cases {
+		m = c.do(m)
+	}
+
+	for k, want := range map[int]string{1: "one", 2: "two", 3: "three"} {
+		if got, ok := m.Lookup(k); !ok || got != want {
+			t.Fatalf("Lookup(%d) = %q, %v; want %q, true", k, got, ok, want)
+		}
+	}
+
+	// Keys() must be ordered by the reverse comparator, not numeric ascending order.
+	var keys []int
+	for cur := m.Keys(); !cur.IsEmpty(); cur = cur.Tail() {
+		keys = append(keys, cur.Head())
+	}
+	want := []int{3, 2, 1}
+	if len(keys) != len(want) {
+		t.Fatalf("Keys() = %v, want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Fatalf("Keys() = %v, want %v", keys, want)
+		}
+	}
+
+	m = m.Delete(2)
+	if _, ok := m.Lookup(2); ok {
+		t.Fatalf("Lookup(2) found after Delete(2)")
+	}
+	if _, ok := m.Lookup(1); !ok {
+		t.Fatalf("Lookup(1) missing after unrelated Delete(2)")
+	}
+}
+
+// TestOrderedMapMonotonicInsertStaysBalanced guards against the AVL tree
+// degenerating into a linked list under a monotonic (already-sorted) key
+// sequence, which is the most natural insertion order for an ordered map.
+func TestOrderedMapMonotonicInsertStaysBalanced(t *testing.T) {
+	ascending := func(a, b int) int { return a - b }
+	m := EmptyOrderedMap[int, int](ascending)
+	const n = 2000
+	for i := 0; i < n; i++ {
+		m = m.Insert(i, i*i)
+	}
+	for i := 0; i < n; i += 137 {
+		if v, ok := m.Lookup(i); !ok || v != i*i {
+			t.Fatalf("Lookup(%d) = %v, %v; want %v, true", i, v, ok, i*i)
+		}
+	}
+	if h := height(m.root); h > 2*intLog2(n+1)+2 {
+		t.Fatalf("tree height %d too large for %d monotonic inserts; AVL balance likely broken", h, n)
+	}
+}
+
+func intLog2(n int) int {
+	l := 0
+	for n > 1 {
+		n >>= 1
+		l++
+	}
+	return l
+}
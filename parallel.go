@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// DispatchingStrategy assigns work items (by index) to worker goroutines.
+// Given the total number of items and workers, Assign returns the worker id
+// (in [0, workers)) responsible for item i.
+type DispatchingStrategy interface {
+	Assign(i, total, workers int) int
+}
+
+// RoundRobin is a DispatchingStrategy that assigns consecutive items to
+// successive workers in a cycle.
+type RoundRobin struct{}
+
+// Assign implements DispatchingStrategy.
+func (RoundRobin) Assign(i, total, workers int) int { return i % workers }
+
+// LeastLoaded is a DispatchingStrategy that assigns each item to the worker
+// that currently holds the fewest items, computed from the static item
+// count rather than live runtime load.
+type LeastLoaded struct{}
+
+// Assign implements DispatchingStrategy by distributing items into workers
+// in contiguous, evenly-sized blocks so no worker is assigned more than
+// ceil(total/workers) items.
+func (LeastLoaded) Assign(i, total, workers int) int {
+	chunk := (total + workers - 1) / workers
+	if chunk == 0 {
+		return 0
+	}
+	return i / chunk
+}
+
+// toSlice materializes l into a slice, preserving order.
+func toSlice[T any](l List[T]) []T {
+	var out []T
+	for cur := l; !cur.IsEmpty(); cur = cur.Tail() {
+		out = append(out, cur.Head())
+	}
+	return out
+}
+
+// runParallel dispatches indices [0, len(items)) to workers goroutines
+// according to strategy, invoking work(i) for each and returning once all
+// have completed.
+func runParallel(total, workers int, strategy DispatchingStrategy, work func(i int)) {
+	if workers < 1 {
+		workers = 1
+	}
+	var wg sync.WaitGroup
+	queues := make([][]int, workers)
+	for i := 0; i < total; i++ {
+		w := strategy.Assign(i, total, workers)
+		queues[w] = append(queues[w], i)
+	}
+	for _, queue := range queues {
+		queue := queue
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, i := range queue {
+				work(i)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// ParallelMap applies f to each element of l across workers goroutines,
+// dispatched according to strategy, and reassembles the results in the
+// original list order, so the output is identical to the sequential Map
+// regardless of scheduling.
+func ParallelMap[F any, T any](l List[F], f func(F) T, workers int, strategy DispatchingStrategy) List[T] {
+	items := toSlice(l)
+	results := make([]T, len(items))
+	runParallel(len(items), workers, strategy, func(i int) {
+		results[i] = f(items[i])
+	})
+	return fromSliceRev(results)
+}
+
+// ParallelFilter evaluates p for each element of l across workers goroutines,
+// dispatched according to strategy, and returns a new list containing the
+// elements that satisfy p, in their original relative order.
+func ParallelFilter[T any](l List[T], p func(T) bool, workers int, strategy DispatchingStrategy) List[T] {
+	items := toSlice(l)
+	keep := make([]bool, len(items))
+	runParallel(len(items), workers, strategy, func(i int) {
+		keep[i] = p(items[i])
+	})
+	var kept []T
+	for i, v := range items {
+		if keep[i] {
+			kept = append(kept, v)
+		}
+	}
+	return fromSliceRev(kept)
+}
+
+// ParallelFold reduces l using combine, which must be associative, and
+// identity. Elements are combined pairwise across workers goroutines and the
+// partial results are folded together sequentially, so the associativity
+// requirement guarantees the same result as a sequential Fold.
+func ParallelFold[T any](l List[T], combine func(a, b T) T, identity T, workers int) T {
+	items := toSlice(l)
+	if len(items) == 0 {
+		return identity
+	}
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+	partials := make([]T, workers)
+	for w := range partials {
+		partials[w] = identity
+	}
+	var wg sync.WaitGroup
+	chunk := (len(items) + workers - 1) / workers
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		end := start + chunk
+		if start >= len(items) {
+			continue
+		}
+		if end > len(items) {
+			end = len(items)
+		}
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			acc := identity
+			for _, v := range items[start:end] {
+				acc = combine(acc, v)
+			}
+			partials[w] = acc
+		}(w, start, end)
+	}
+	wg.Wait()
+	result := identity
+	for _, p := range partials {
+		result = combine(result, p)
+	}
+	return result
+}
+
+// ParallelMapE behaves like ParallelMap but returns an error if f fails on
+// any element. As soon as the first failure occurs, ctx is canceled so that
+// remaining in-flight work can stop early; f should observe ctx itself to
+// benefit from early cancellation.
+func ParallelMapE[F any, T any](ctx context.Context, l List[F], f func(context.Context, F) (T, error), workers int, strategy DispatchingStrategy) (List[T], error) {
+	items := toSlice(l)
+	results := make([]T, len(items))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	runParallel(len(items), workers, strategy, func(i int) {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+		v, err := f(ctx, items[i])
+		if err != nil {
+			mu.Lock()
+			if firstErr == nil {
+				firstErr = err
+				cancel()
+			}
+			mu.Unlock()
+			return
+		}
+		results[i] = v
+	})
+
+	if firstErr != nil {
+		return EmptyList[T]{}, firstErr
+	}
+	return fromSliceRev(results), nil
+}
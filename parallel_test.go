@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func rangeList(n int) List[int] {
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	return FromSlice(items)
+}
+
+func intSlicesEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestParallelMapMatchesSequential(t *testing.T) {
+	l := rangeList(997) // prime length exercises uneven worker splits
+	double := func(x int) int { return x * 2 }
+	want := toSlice(Map(l, double))
+
+	for _, strategy := range []DispatchingStrategy{RoundRobin{}, LeastLoaded{}} {
+		for _, workers := range []int{1, 2, 3, 8, 32} {
+			got := toSlice(ParallelMap(l, double, workers, strategy))
+			if !intSlicesEqual(got, want) {
+				t.Fatalf("ParallelMap(workers=%d, %T) = %v, want %v", workers, strategy, got, want)
+			}
+		}
+	}
+}
+
+func TestParallelFilterMatchesSequential(t *testing.T) {
+	l := rangeList(997)
+	even := func(x int) bool { return x%2 == 0 }
+	want := toSlice(Filter(l, even))
+
+	for _, strategy := range []DispatchingStrategy{RoundRobin{}, LeastLoaded{}} {
+		for _, workers := range []int{1, 2, 3, 8, 32} {
+			got := toSlice(ParallelFilter(l, even, workers, strategy))
+			if !intSlicesEqual(got, want) {
+				t.Fatalf("ParallelFilter(workers=%d, %T) = %v, want %v", workers, strategy, got, want)
+			}
+		}
+	}
+}
+
+func TestParallelFoldAssociativeCombiner(t *testing.T) {
+	l := rangeList(997)
+	sum := func(a, b int) int { return a + b }
+	want := Fold(l, func(v, acc int) int { return acc + v }, 0)
+
+	for _, workers := range []int{1, 2, 3, 8, 32} {
+		got := ParallelFold(l, sum, 0, workers)
+		if got != want {
+			t.Fatalf("ParallelFold(workers=%d) = %d, want %d", workers, got, want)
+		}
+	}
+}
+
+func TestParallelMapEShortCircuitsOnFirstError(t *testing.T) {
+	const total = 5000
+	l := rangeList(total)
+	errBoom := errors.New("boom")
+	var calls int32
+
+	// FromSlice prepends, so the front of l is the last value pushed in
+	// rangeList; trigger on that front element so the failure is seen on
+	// the very first item a worker processes, not buried near the end.
+	triggerVal := l.Head()
+
+	f := func(ctx context.Context, x int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		if x == triggerVal {
+			return 0, errBoom
+		}
+		time.Sleep(time.Millisecond)
+		return x, nil
+	}
+
+	_, err := ParallelMapE(context.Background(), l, f, 4, RoundRobin{})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("ParallelMapE error = %v, want %v", err, errBoom)
+	}
+	if got := atomic.LoadInt32(&calls); got >= total {
+		t.Fatalf("ParallelMapE made %d calls out of %d; expected early cancellation to skip most of them", got, total)
+	}
+}
+
+func TestParallelMapENoError(t *testing.T) {
+	l := rangeList(997)
+	f := func(ctx context.Context, x int) (int, error) { return x * 2, nil }
+	want := toSlice(Map(l, func(x int) int { return x * 2 }))
+
+	result, err := ParallelMapE(context.Background(), l, f, 8, RoundRobin{})
+	if err != nil {
+		t.Fatalf("ParallelMapE returned unexpected error: %v", err)
+	}
+	if got := toSlice(result); !intSlicesEqual(got, want) {
+		t.Fatalf("ParallelMapE result = %v, want %v", got, want)
+	}
+}
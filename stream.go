@@ -0,0 +1,125 @@
+package main
+
+import (
+	"cmp"
+	"sort"
+)
+
+// Comparator orders two values of type T, returning a negative number if a
+// should sort before b, zero if they are equivalent, and a positive number
+// if a should sort after b.
+type Comparator[T any] func(a, b T) int
+
+// Accumulator combines an accumulated result R with each element of type T,
+// as used by Stream.Reduce.
+type Accumulator[T any, R any] func(acc R, val T) R
+
+// NaturalOrder returns a Comparator that orders values of an ordered type
+// from smallest to largest.
+func NaturalOrder[T cmp.Ordered]() Comparator[T] {
+	return func(a, b T) int { return cmp.Compare(a, b) }
+}
+
+// Reversed returns a Comparator that orders elements in the opposite order
+// of c.
+func Reversed[T any](c Comparator[T]) Comparator[T] {
+	return func(a, b T) int { return c(b, a) }
+}
+
+// ByKey returns a Comparator that orders elements by the natural order of
+// the key extracted by f.
+func ByKey[T any, K cmp.Ordered](f func(T) K) Comparator[T] {
+	return func(a, b T) int { return cmp.Compare(f(a), f(b)) }
+}
+
+// Stream is a fluent, chainable wrapper around List[T] offering sort,
+// min/max, distinct, and reduce operations without changing the List[T]
+// interface itself.
+type Stream[T any] struct {
+	list List[T]
+}
+
+// StreamOf wraps l in a Stream[T].
+func StreamOf[T any](l List[T]) Stream[T] {
+	return Stream[T]{list: l}
+}
+
+// Sort returns a new Stream with its elements ordered according to c.
+func (s Stream[T]) Sort(c Comparator[T]) Stream[T] {
+	var elems []T
+	for cur := s.list; !cur.IsEmpty(); cur = cur.Tail() {
+		elems = append(elems, cur.Head())
+	}
+	sort.SliceStable(elems, func(i, j int) bool { return c(elems[i], elems[j]) < 0 })
+	return Stream[T]{list: fromSliceRev(elems)}
+}
+
+// Min returns the smallest element according to c, and false if the stream
+// is empty.
+func (s Stream[T]) Min(c Comparator[T]) (T, bool) {
+	cur := s.list
+	if cur.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	min := cur.Head()
+	for cur = cur.Tail(); !cur.IsEmpty(); cur = cur.Tail() {
+		if v := cur.Head(); c(v, min) < 0 {
+			min = v
+		}
+	}
+	return min, true
+}
+
+// Max returns the largest element according to c, and false if the stream
+// is empty.
+func (s Stream[T]) Max(c Comparator[T]) (T, bool) {
+	cur := s.list
+	if cur.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	max := cur.Head()
+	for cur = cur.Tail(); !cur.IsEmpty(); cur = cur.Tail() {
+		if v := cur.Head(); c(v, max) > 0 {
+			max = v
+		}
+	}
+	return max, true
+}
+
+// Distinct returns a new Stream with later elements considered equal to an
+// earlier one (per eq) removed, preserving order of first occurrence.
+func (s Stream[T]) Distinct(eq func(a, b T) bool) Stream[T] {
+	var kept []T
+	for cur := s.list; !cur.IsEmpty(); cur = cur.Tail() {
+		v := cur.Head()
+		dup := false
+		for _, k := range kept {
+			if eq(k, v) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			kept = append(kept, v)
+		}
+	}
+	return Stream[T]{list: fromSliceRev(kept)}
+}
+
+// Reduce folds a Stream's elements into a single result of type R using acc,
+// starting from init. It is a free function rather than a method because Go
+// methods cannot introduce the additional type parameter R.
+func Reduce[T any, R any](s Stream[T], acc Accumulator[T, R], init R) R {
+	result := init
+	for cur := s.list; !cur.IsEmpty(); cur = cur.Tail() {
+		result = acc(result, cur.Head())
+	}
+	return result
+}
+
+// Collect returns the Stream's elements as a List[T].
+func (s Stream[T]) Collect() List[T] {
+	return s.list
+}